@@ -0,0 +1,103 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// A ConnectionDetailTransformType is a type of connection detail transform.
+type ConnectionDetailTransformType string
+
+// Connection detail transform types.
+const (
+	ConnectionDetailTransformTypeRename   ConnectionDetailTransformType = "Rename"
+	ConnectionDetailTransformTypeTemplate ConnectionDetailTransformType = "Template"
+	ConnectionDetailTransformTypeMap      ConnectionDetailTransformType = "Map"
+	ConnectionDetailTransformTypeDrop     ConnectionDetailTransformType = "Drop"
+	ConnectionDetailTransformTypeRequire  ConnectionDetailTransformType = "Require"
+)
+
+// A ConnectionDetailTransform is applied to a connection detail before it's
+// published. Exactly one of Rename, Template, Map, Drop, or Require should
+// be set, matching Type.
+type ConnectionDetailTransform struct {
+	// Type of transform to apply.
+	// +kubebuilder:validation:Enum=Rename;Template;Map;Drop;Require
+	Type ConnectionDetailTransformType `json:"type"`
+
+	// Rename changes the key a connection detail is published under.
+	// +optional
+	Rename *RenameConnectionDetailTransform `json:"rename,omitempty"`
+
+	// Template renders a connection detail's value from a Go template that
+	// may reference any other connection detail by key.
+	// +optional
+	Template *TemplateConnectionDetailTransform `json:"template,omitempty"`
+
+	// Map replaces a connection detail's value using a lookup table keyed by
+	// its current value.
+	// +optional
+	Map *MapConnectionDetailTransform `json:"map,omitempty"`
+
+	// Drop removes a connection detail.
+	// +optional
+	Drop *DropConnectionDetailTransform `json:"drop,omitempty"`
+
+	// Require asserts that a connection detail is present, failing the
+	// publish if it is not.
+	// +optional
+	Require *RequireConnectionDetailTransform `json:"require,omitempty"`
+}
+
+// A RenameConnectionDetailTransform renames a connection detail key.
+type RenameConnectionDetailTransform struct {
+	// Key of the connection detail to rename.
+	Key string `json:"key"`
+
+	// To is the new key.
+	To string `json:"to"`
+}
+
+// A TemplateConnectionDetailTransform templates a connection detail value.
+type TemplateConnectionDetailTransform struct {
+	// Key of the connection detail to set.
+	Key string `json:"key"`
+
+	// Template is a Go template string. It has access to all of the
+	// resource's other connection details.
+	Template string `json:"template"`
+}
+
+// A MapConnectionDetailTransform replaces a connection detail's value using
+// a lookup table.
+type MapConnectionDetailTransform struct {
+	// Key of the connection detail to map.
+	Key string `json:"key"`
+
+	// Pairs maps a connection detail's current value to its new value.
+	Pairs map[string]string `json:"pairs"`
+}
+
+// A DropConnectionDetailTransform removes a connection detail.
+type DropConnectionDetailTransform struct {
+	// Key of the connection detail to drop.
+	Key string `json:"key"`
+}
+
+// A RequireConnectionDetailTransform asserts that a connection detail key is
+// present.
+type RequireConnectionDetailTransform struct {
+	// Key of the connection detail that must be present.
+	Key string `json:"key"`
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// A ConnectionDetailsSource specifies where a resource.ConnectionDetailsFetcher
+// should import its connection details from. Exactly one of SecretRef or
+// SecretStoreConfigRef should be set.
+type ConnectionDetailsSource struct {
+	// SecretRef is a reference to a Kubernetes Secret that contains the
+	// connection details to fetch, e.g. one managed by a Helm release or
+	// another operator.
+	// +optional
+	SecretRef *ConnectionDetailsSecretRef `json:"secretRef,omitempty"`
+
+	// SecretStoreConfigRef references the config of the secret store from
+	// which connection details should be fetched, e.g. a Vault instance
+	// mounted via the CSI driver.
+	// +optional
+	SecretStoreConfigRef *StoreConfigReference `json:"secretStoreConfigRef,omitempty"`
+}
+
+// A ConnectionDetailsSecretRef identifies a Kubernetes Secret to fetch
+// connection details from, and optionally remaps its keys.
+type ConnectionDetailsSecretRef struct {
+	// Namespace of the referenced Secret.
+	Namespace string `json:"namespace"`
+
+	// Name of the referenced Secret.
+	Name string `json:"name"`
+
+	// Keys maps the connection detail key that should be published to the
+	// key that should be read from the referenced Secret. If empty, every
+	// key in the referenced Secret is imported as-is.
+	// +optional
+	Keys map[string]string `json:"keys,omitempty"`
+}
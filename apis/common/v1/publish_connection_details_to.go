@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// A PublishConnectionDetailsTo refers to the secret store connection details
+// should be published to. This is the type resource.ConnectionDetailsPublisherTo
+// gets and sets; its Name, SecretStoreConfigRef, and Metadata fields are
+// unchanged here, and Transforms is the only addition.
+type PublishConnectionDetailsTo struct {
+	// Name is the name of the connection secret.
+	Name string `json:"name"`
+
+	// SecretStoreConfigRef specifies which secret store config should be
+	// used for this ConnectionSecret.
+	// +optional
+	// +kubebuilder:default={"name": "default"}
+	SecretStoreConfigRef *StoreConfigReference `json:"configRef,omitempty"`
+
+	// Metadata is the metadata for connection secret.
+	// +optional
+	Metadata *ConnectionDetailsMetadata `json:"metadata,omitempty"`
+
+	// Transforms is an ordered list of transforms that should be applied to
+	// the connection details before they're published. For example a
+	// transform might rename a key, template a new value from existing
+	// ones, map a value to another, drop a key, or require that a key be
+	// present.
+	// +optional
+	Transforms []ConnectionDetailTransform `json:"transforms,omitempty"`
+}
+
+// ConnectionDetailsMetadata provides metadata about the secret a connection
+// details are published to.
+type ConnectionDetailsMetadata struct {
+	// Labels are the labels to be added to connection secret.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are the annotations to be added to connection secret.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Type is the SecretType for the connection secret.
+	// +optional
+	Type *string `json:"type,omitempty"`
+}
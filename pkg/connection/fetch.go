@@ -0,0 +1,45 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A DetailsFetcher fetches connection details from a source other than the
+// resource that is publishing them, e.g. a Secret managed by a Helm release
+// or an entry in an external secret store.
+type DetailsFetcher interface {
+	FetchConnectionDetails(ctx context.Context, from resource.ConnectionDetailsFetcher) (map[string][]byte, error)
+}
+
+// MergeFetchedConnectionDetails merges details fetched on behalf of a
+// ConnectionDetailsFetcher into an existing set of connection details.
+// Existing keys always win, so a resource's own published details take
+// precedence over anything it imports.
+func MergeFetchedConnectionDetails(existing, fetched map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(existing)+len(fetched))
+	for k, v := range fetched {
+		out[k] = v
+	}
+	for k, v := range existing {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeFetchedConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		existing map[string][]byte
+		fetched  map[string][]byte
+		want     map[string][]byte
+	}{
+		"FetchedFillsGaps": {
+			existing: map[string][]byte{"username": []byte("self")},
+			fetched:  map[string][]byte{"password": []byte("imported")},
+			want:     map[string][]byte{"username": []byte("self"), "password": []byte("imported")},
+		},
+		"ExistingWinsOnConflict": {
+			existing: map[string][]byte{"username": []byte("self")},
+			fetched:  map[string][]byte{"username": []byte("imported")},
+			want:     map[string][]byte{"username": []byte("self")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MergeFetchedConnectionDetails(tc.existing, tc.fetched)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("MergeFetchedConnectionDetails(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
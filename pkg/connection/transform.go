@@ -0,0 +1,37 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A Transformer applies the connection detail transforms specified by a
+// ConnectionDetailsPublisherTo to a set of connection details, before they
+// are handed to a Store's PublishConnection call.
+type Transformer struct{}
+
+// NewTransformer returns a Transformer.
+func NewTransformer() *Transformer {
+	return &Transformer{}
+}
+
+// Transform applies the supplied transforms to details, in order.
+func (t *Transformer) Transform(details map[string][]byte, transforms ...xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	return resource.ApplyConnectionDetailTransforms(details, transforms...)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// WithPollJitter specifies the percentage of jitter to apply to the poll
+// interval of resources that don't specify their own via PollJitterer. For
+// example a percent of 0.05 jitters the poll interval by +/- 5%. This is used
+// to avoid many resources being requeued for a reconcile at exactly the same
+// time, which can otherwise cause spikes in external API call rates.
+func WithPollJitter(percent float64) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pollJitterPercent = percent
+	}
+}
+
+// jitterPollInterval returns the supplied poll interval adjusted by a random
+// offset of +/- percent, where percent takes priority from mg if it
+// implements resource.PollJitterer and specifies a non-nil value, falling
+// back to the supplied default otherwise. The jittered interval is never
+// allowed to fall below half of the unjittered interval.
+func jitterPollInterval(interval time.Duration, percent float64, mg resource.Managed) time.Duration {
+	if pj, ok := mg.(resource.PollJitterer); ok {
+		if p := pj.GetPollJitterPercent(); p != nil {
+			percent = *p
+		}
+	}
+
+	if percent <= 0 {
+		return interval
+	}
+
+	offset := time.Duration((rand.Float64()*2 - 1) * percent * float64(interval)) //nolint:gosec // No need for secure randomness here.
+	jittered := interval + offset
+
+	if floor := interval / 2; jittered < floor {
+		return floor
+	}
+
+	return jittered
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestJitterPollInterval(t *testing.T) {
+	interval := 10 * time.Minute
+
+	type args struct {
+		interval time.Duration
+		percent  float64
+		mg       *fake.Managed
+	}
+	cases := map[string]struct {
+		args args
+		want func(time.Duration) bool
+	}{
+		"NoJitter": {
+			args: args{interval: interval, percent: 0, mg: &fake.Managed{}},
+			want: func(got time.Duration) bool { return got == interval },
+		},
+		"ReconcilerDefaultJitterWithinBounds": {
+			args: args{interval: interval, percent: 0.05, mg: &fake.Managed{}},
+			want: func(got time.Duration) bool {
+				lo, hi := interval-time.Duration(0.05*float64(interval)), interval+time.Duration(0.05*float64(interval))
+				return got >= lo && got <= hi
+			},
+		},
+		"ResourceOverridesReconcilerDefault": {
+			args: args{interval: interval, percent: 0.05, mg: func() *fake.Managed {
+				mg := &fake.Managed{}
+				p := 0.5
+				mg.SetPollJitterPercent(&p)
+				return mg
+			}()},
+			want: func(got time.Duration) bool {
+				lo, hi := interval-time.Duration(0.5*float64(interval)), interval+time.Duration(0.5*float64(interval))
+				return got >= lo && got <= hi
+			},
+		},
+		"FloorsAtHalfTheInterval": {
+			args: args{interval: interval, percent: 10, mg: &fake.Managed{}},
+			want: func(got time.Duration) bool {
+				lo, hi := interval/2, interval+time.Duration(10*float64(interval))
+				return got >= lo && got <= hi
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got := jitterPollInterval(tc.args.interval, tc.args.percent, tc.args.mg)
+				if !tc.want(got) {
+					t.Fatalf("jitterPollInterval(...): got %v which violates the expected bound", got)
+				}
+			}
+		})
+	}
+}
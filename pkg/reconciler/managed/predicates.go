@@ -0,0 +1,45 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// WithObserveOnlyEventFilter returns a predicate that ignores update events
+// for observe-only managed resources whose spec has not changed. This stops
+// an informer from requeueing observe-only resources every time their status
+// is updated, since there is nothing for the reconciler to act on but the
+// next scheduled observation.
+func WithObserveOnlyEventFilter() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			mg, ok := e.ObjectNew.(resource.Managed)
+			if !ok || !resource.IsObserveOnly(mg) {
+				return true
+			}
+			old, ok := e.ObjectOld.(resource.Managed)
+			if !ok {
+				return true
+			}
+			return old.GetGeneration() != mg.GetGeneration()
+		},
+	}
+}
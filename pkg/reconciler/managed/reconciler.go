@@ -0,0 +1,322 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managed reconciles Kubernetes resources that represent concrete
+// managed resources with the external systems they represent.
+package managed
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// finalizerName is added to a managed resource so that the Reconciler can
+// ensure its external resource is deleted before the managed resource is.
+const finalizerName = "finalizer.managedresource.crossplane.io"
+
+// defaultPollInterval is how often a managed resource is reconciled in the
+// absence of any other reason to do so.
+const defaultPollInterval = 1 * time.Minute
+
+const (
+	errGetManaged    = "cannot get managed resource"
+	errUpdateStatus  = "cannot update managed resource status"
+	errConnect       = "cannot connect to provider"
+	errObserve       = "cannot observe external resource"
+	errCreate        = "cannot create external resource"
+	errUpdate        = "cannot update external resource"
+	errDelete        = "cannot delete external resource"
+	errAddFinalizer  = "cannot add finalizer to managed resource"
+	errRemFinalizer  = "cannot remove finalizer from managed resource"
+	errObserveOnlyNX = "observe-only resource does not exist"
+	errFetchDetails  = "cannot fetch imported connection details"
+	errTransform     = "cannot transform connection details"
+	errPublish       = "cannot publish connection details"
+)
+
+// ConnectionDetails created or updated during an operation on an external
+// resource, for example usernames, passwords, endpoints, and ports.
+type ConnectionDetails map[string][]byte
+
+// An ExternalObservation is the result of an observation of an external
+// resource.
+type ExternalObservation struct {
+	// ResourceExists must be true if a corresponding external resource
+	// exists for the managed resource.
+	ResourceExists bool
+
+	// ResourceUpToDate must be true if the corresponding external resource
+	// does not require any Update calls to be made.
+	ResourceUpToDate bool
+
+	// ConnectionDetails observed on the external resource, if any.
+	ConnectionDetails ConnectionDetails
+}
+
+// An ExternalClient manages the lifecycle of an external resource on behalf
+// of a Managed resource.
+type ExternalClient interface {
+	Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error)
+	Create(ctx context.Context, mg resource.Managed) (ConnectionDetails, error)
+	Update(ctx context.Context, mg resource.Managed) (ConnectionDetails, error)
+	Delete(ctx context.Context, mg resource.Managed) error
+}
+
+// An ExternalConnecter produces a new ExternalClient given the supplied
+// Managed resource.
+type ExternalConnecter interface {
+	Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error)
+}
+
+// A ConnectionPublisher publishes the supplied ConnectionDetails for the
+// supplied Managed resource, for example by writing them to a Secret.
+type ConnectionPublisher interface {
+	PublishConnection(ctx context.Context, mg resource.Managed, c ConnectionDetails) error
+}
+
+// A ConnectionDetailsTransformer applies a Managed resource's configured
+// connection detail transforms before its connection details are published.
+type ConnectionDetailsTransformer interface {
+	Transform(details map[string][]byte, transforms ...xpv1.ConnectionDetailTransform) (map[string][]byte, error)
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithPollInterval specifies how often the Reconciler should requeue a
+// managed resource in the absence of any other reason to do so.
+func WithPollInterval(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pollInterval = d
+	}
+}
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// WithConnectionPublishers specifies how the Reconciler should publish
+// connection details, including any imported via a
+// resource.ConnectionDetailsFetcher.
+func WithConnectionPublishers(p ...ConnectionPublisher) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connectionPublishers = p
+	}
+}
+
+// WithConnectionDetailsFetcher specifies how the Reconciler should fetch
+// connection details on behalf of a resource.ConnectionDetailsFetcher, to be
+// merged with any connection details observed on the external resource
+// itself before they're published.
+func WithConnectionDetailsFetcher(f connection.DetailsFetcher) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connectionDetailsFetcher = f
+	}
+}
+
+// WithConnectionDetailsTransformer specifies how the Reconciler should apply
+// a managed resource's configured connection detail transforms before they
+// are published.
+func WithConnectionDetailsTransformer(t ConnectionDetailsTransformer) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connectionDetailsTransformer = t
+	}
+}
+
+// A Reconciler reconciles managed resources by creating and managing the
+// lifecycle of a corresponding external resource.
+type Reconciler struct {
+	client client.Client
+
+	newManaged func() resource.Managed
+	connecter  ExternalConnecter
+
+	pollInterval      time.Duration
+	pollJitterPercent float64
+
+	connectionPublishers         []ConnectionPublisher
+	connectionDetailsFetcher     connection.DetailsFetcher
+	connectionDetailsTransformer ConnectionDetailsTransformer
+
+	finalizer resource.Finalizer
+
+	log logging.Logger
+}
+
+// NewReconciler returns a Reconciler of managed resources produced by the
+// supplied function, whose external lifecycle is managed by the supplied
+// ExternalConnecter.
+func NewReconciler(c client.Client, of func() resource.Managed, e ExternalConnecter, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client:                       c,
+		newManaged:                   of,
+		connecter:                    e,
+		pollInterval:                 defaultPollInterval,
+		finalizer:                    resource.NewAPIFinalizer(c, finalizerName),
+		connectionDetailsTransformer: connection.NewTransformer(),
+		log:                          logging.NewNopLogger(),
+	}
+
+	for _, ro := range o {
+		ro(r)
+	}
+
+	return r
+}
+
+// Reconcile a managed resource with an external resource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	mg := r.newManaged()
+	if err := r.client.Get(ctx, req.NamespacedName, mg); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
+	}
+
+	log = log.WithValues("external-id", resource.GetExternalID(mg))
+	observeOnly := resource.IsObserveOnly(mg)
+
+	external, err := r.connecter.Connect(ctx, mg)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errConnect)))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	observation, err := external.Observe(ctx, mg)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errObserve)))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if !observation.ResourceExists {
+		if observeOnly {
+			// An observe-only resource that does not exist cannot be
+			// created. This is a terminal condition requiring operator
+			// intervention, not something a reconcile retry can fix.
+			mg.SetConditions(xpv1.ReconcileError(errors.New(errObserveOnlyNX)))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		details, err := external.Create(ctx, mg)
+		if err != nil {
+			mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errCreate)))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		if err := r.publish(ctx, mg, details); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		mg.SetConditions(xpv1.ReconcileSuccess())
+		return reconcile.Result{RequeueAfter: jitterPollInterval(r.pollInterval, r.pollJitterPercent, mg)}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if err := r.publish(ctx, mg, observation.ConnectionDetails); err != nil {
+		mg.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if observeOnly {
+		// Never create, update, delete, or add our finalizer to an
+		// observe-only resource's external resource.
+		log.Debug("Successfully observed observe-only managed resource")
+		mg.SetConditions(xpv1.ReconcileSuccess())
+		return reconcile.Result{RequeueAfter: jitterPollInterval(r.pollInterval, r.pollJitterPercent, mg)}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if meta.WasDeleted(mg) {
+		if err := external.Delete(ctx, mg); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errDelete)))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		if err := r.finalizer.RemoveFinalizer(ctx, mg); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errRemFinalizer)))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, mg); err != nil {
+		mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errAddFinalizer)))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+	}
+
+	if !observation.ResourceUpToDate {
+		details, err := external.Update(ctx, mg)
+		if err != nil {
+			mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errUpdate)))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+
+		if err := r.publish(ctx, mg, details); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+		}
+	}
+
+	log.Debug("Successfully reconciled managed resource")
+	mg.SetConditions(xpv1.ReconcileSuccess())
+	return reconcile.Result{RequeueAfter: jitterPollInterval(r.pollInterval, r.pollJitterPercent, mg)}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateStatus)
+}
+
+// publish merges any connection details imported via mg's
+// resource.ConnectionDetailsFetcher with those observed on the external
+// resource, then hands the result to every configured ConnectionPublisher.
+func (r *Reconciler) publish(ctx context.Context, mg resource.Managed, observed ConnectionDetails) error {
+	merged := map[string][]byte(observed)
+
+	if cdf, ok := mg.(resource.ConnectionDetailsFetcher); ok && r.connectionDetailsFetcher != nil && cdf.GetFetchConnectionDetailsFrom() != nil {
+		fetched, err := r.connectionDetailsFetcher.FetchConnectionDetails(ctx, cdf)
+		if err != nil {
+			return errors.Wrap(err, errFetchDetails)
+		}
+		merged = connection.MergeFetchedConnectionDetails(merged, fetched)
+	}
+
+	if pct, ok := mg.(resource.ConnectionDetailsPublisherTo); ok && r.connectionDetailsTransformer != nil {
+		if pc := pct.GetPublishConnectionDetailsTo(); pc != nil && len(pc.Transforms) > 0 {
+			transformed, err := r.connectionDetailsTransformer.Transform(merged, pc.Transforms...)
+			if err != nil {
+				return errors.Wrap(err, errTransform)
+			}
+			merged = transformed
+		}
+	}
+
+	for _, p := range r.connectionPublishers {
+		if err := p.PublishConnection(ctx, mg, merged); err != nil {
+			return errors.Wrap(err, errPublish)
+		}
+	}
+
+	return nil
+}
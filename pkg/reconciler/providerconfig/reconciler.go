@@ -0,0 +1,223 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerconfig reconciles ProviderConfigs by tracking how many
+// managed resources use each one.
+package providerconfig
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// inUseFinalizer is added to a ProviderConfig, and the RuntimeConfig it
+// references, while at least one ProviderConfigUsage references them.
+const inUseFinalizer = "in-use.crossplane.io"
+
+const (
+	errGetProviderConfig   = "cannot get provider config"
+	errListUsages          = "cannot list provider config usages"
+	errListProviderConfigs = "cannot list provider configs"
+	errAddFinalizer        = "cannot add in-use finalizer"
+	errRemFinalizer        = "cannot remove in-use finalizer"
+	errUpdateStatus        = "cannot update provider config status"
+	errResolveRuntimeConf  = "cannot resolve runtime config"
+)
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// A Reconciler reconciles ProviderConfigs. It counts the ProviderConfigUsages
+// that reference each ProviderConfig, maintains an in-use finalizer that
+// blocks its deletion while that count is non-zero, and mirrors the same
+// accounting onto the RuntimeConfig the ProviderConfig references, if any.
+// Because more than one ProviderConfig may resolve to the same RuntimeConfig,
+// the RuntimeConfig's count is the sum of usages across every ProviderConfig
+// that resolves to it, not just the one being reconciled.
+type Reconciler struct {
+	client client.Client
+
+	newProviderConfig     func() resource.ProviderConfig
+	newProviderConfigList func() resource.ProviderConfigList
+	newUsageList          func() resource.ProviderConfigUsageList
+
+	resolveRuntimeConfig RuntimeConfigResolver
+
+	finalizer resource.Finalizer
+
+	log logging.Logger
+}
+
+// NewReconciler returns a Reconciler of ProviderConfigs produced by the
+// supplied function, whose usages are provided by the supplied
+// ProviderConfigUsageList function. ofList is used to enumerate every
+// ProviderConfig of this kind when a RuntimeConfigResolver is configured, so
+// that a RuntimeConfig's usage count can be aggregated across all of them.
+func NewReconciler(c client.Client, of func() resource.ProviderConfig, ofList func() resource.ProviderConfigList, ofUsageList func() resource.ProviderConfigUsageList, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client:                c,
+		newProviderConfig:     of,
+		newProviderConfigList: ofList,
+		newUsageList:          ofUsageList,
+		finalizer:             resource.NewAPIFinalizer(c, inUseFinalizer),
+		log:                   logging.NewNopLogger(),
+	}
+
+	for _, ro := range o {
+		ro(r)
+	}
+
+	return r
+}
+
+// Reconcile a ProviderConfig by counting the ProviderConfigUsages that
+// reference it.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	pc := r.newProviderConfig()
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetProviderConfig)
+	}
+
+	ul := r.newUsageList()
+	if err := r.client.List(ctx, ul); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListUsages)
+	}
+
+	used := countProviderConfigUsages(ul, pc.GetName())
+
+	if used > 0 {
+		if err := r.finalizer.AddFinalizer(ctx, pc); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errAddFinalizer)
+		}
+	} else if err := r.finalizer.RemoveFinalizer(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errRemFinalizer)
+	}
+
+	pc.SetUsers(used)
+	if err := r.client.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+
+	if r.resolveRuntimeConfig == nil {
+		return reconcile.Result{}, nil
+	}
+
+	rc, err := r.resolveRuntimeConfig(ctx, pc)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errResolveRuntimeConf)
+	}
+
+	pcs := r.newProviderConfigList()
+	if err := r.client.List(ctx, pcs); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListProviderConfigs)
+	}
+
+	resolved := make(map[string]client.Object, len(pcs.GetItems()))
+	for _, other := range pcs.GetItems() {
+		if meta.WasDeleted(other) {
+			continue
+		}
+		if orc, err := r.resolveRuntimeConfig(ctx, other); err == nil {
+			resolved[other.GetName()] = orc
+		}
+	}
+
+	rcUsed := sumUsagesForRuntimeConfig(pcs.GetItems(), resolved, ul, rc)
+
+	if rcUsed > 0 {
+		if err := r.finalizer.AddFinalizer(ctx, rc); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errAddFinalizer)
+		}
+	} else if err := r.finalizer.RemoveFinalizer(ctx, rc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errRemFinalizer)
+	}
+
+	uc, ok := rc.(resource.UserCounter)
+	if !ok {
+		log.Debug("Runtime config does not implement UserCounter; its usage count was not updated")
+		return reconcile.Result{}, nil
+	}
+
+	uc.SetUsers(rcUsed)
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, rc), errUpdateStatus)
+}
+
+// countProviderConfigUsages returns the number of live (non-deleted)
+// ProviderConfigUsages in ul that reference the ProviderConfig named name.
+func countProviderConfigUsages(ul resource.ProviderConfigUsageList, name string) int64 {
+	var n int64
+	for _, u := range ul.GetItems() {
+		if meta.WasDeleted(u) {
+			continue
+		}
+		if u.GetProviderConfigReference().Name == name {
+			n++
+		}
+	}
+	return n
+}
+
+// sumUsagesForRuntimeConfig returns the total number of ProviderConfigUsages
+// that reference any live ProviderConfig in pcs whose resolved RuntimeConfig
+// is the same object as target. resolved maps a ProviderConfig's name to the
+// RuntimeConfig it was found to resolve to, if any; a ProviderConfig missing
+// from resolved doesn't reference a resolvable RuntimeConfig and is ignored.
+// Multiple ProviderConfigs can resolve to the same RuntimeConfig, so this
+// must be summed across all of them, not just the ProviderConfig being
+// reconciled.
+func sumUsagesForRuntimeConfig(pcs []resource.ProviderConfig, resolved map[string]client.Object, ul resource.ProviderConfigUsageList, target client.Object) int64 {
+	var total int64
+	for _, pc := range pcs {
+		if meta.WasDeleted(pc) {
+			continue
+		}
+
+		rc, ok := resolved[pc.GetName()]
+		if !ok {
+			continue
+		}
+
+		if rc.GetName() != target.GetName() || rc.GetObjectKind().GroupVersionKind() != target.GetObjectKind().GroupVersionKind() {
+			continue
+		}
+
+		total += countProviderConfigUsages(ul, pc.GetName())
+	}
+	return total
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type providerConfig struct {
+	metav1.ObjectMeta
+	fake.GVK
+
+	fake.RuntimeConfigReferencer
+	fake.UserCounter
+	fake.Conditioned
+}
+
+type providerConfigUsage struct {
+	metav1.ObjectMeta
+	fake.GVK
+
+	fake.RequiredProviderConfigReferencer
+	fake.RequiredTypedResourceReferencer
+}
+
+type providerConfigUsageList struct {
+	metav1.ListMeta
+	fake.GVK
+
+	Items []*providerConfigUsage
+}
+
+func (l *providerConfigUsageList) GetItems() []resource.ProviderConfigUsage {
+	out := make([]resource.ProviderConfigUsage, len(l.Items))
+	for i := range l.Items {
+		out[i] = l.Items[i]
+	}
+	return out
+}
+
+func (l *providerConfigUsageList) DeepCopyObject() runtime.Object { return l }
+
+type runtimeConfig struct {
+	metav1.ObjectMeta
+	fake.GVK
+}
+
+func usage(pcName, usingName string) *providerConfigUsage {
+	u := &providerConfigUsage{}
+	u.RequiredProviderConfigReferencer.SetProviderConfigReference(xpv1.Reference{Name: pcName})
+	u.RequiredTypedResourceReferencer.SetResourceReference(xpv1.TypedReference{Name: usingName})
+	return u
+}
+
+func TestCountProviderConfigUsages(t *testing.T) {
+	cases := map[string]struct {
+		ul   resource.ProviderConfigUsageList
+		name string
+		want int64
+	}{
+		"None": {
+			ul:   &providerConfigUsageList{},
+			name: "cool-pc",
+			want: 0,
+		},
+		"SomeMatch": {
+			ul: &providerConfigUsageList{Items: []*providerConfigUsage{
+				usage("cool-pc", "a"),
+				usage("cool-pc", "b"),
+				usage("other-pc", "c"),
+			}},
+			name: "cool-pc",
+			want: 2,
+		},
+		"IgnoresDeleted": {
+			ul: &providerConfigUsageList{Items: []*providerConfigUsage{
+				usage("cool-pc", "a"),
+				func() *providerConfigUsage {
+					u := usage("cool-pc", "b")
+					now := metav1.Now()
+					u.ObjectMeta.DeletionTimestamp = &now
+					return u
+				}(),
+			}},
+			name: "cool-pc",
+			want: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := countProviderConfigUsages(tc.ul, tc.name); got != tc.want {
+				t.Errorf("countProviderConfigUsages(...): got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSumUsagesForRuntimeConfig(t *testing.T) {
+	rc := &runtimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "shared-rc"}}
+	rc.SetGroupVersionKind(schema.GroupVersionKind{Kind: "RuntimeConfig"})
+
+	otherRC := &runtimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "other-rc"}}
+	otherRC.SetGroupVersionKind(schema.GroupVersionKind{Kind: "RuntimeConfig"})
+
+	a := &providerConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc-a"}}
+	b := &providerConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc-b"}}
+	c := &providerConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc-c"}}
+
+	ul := &providerConfigUsageList{Items: []*providerConfigUsage{
+		usage("pc-a", "mr-1"),
+		usage("pc-a", "mr-2"),
+		usage("pc-b", "mr-3"),
+		usage("pc-c", "mr-4"),
+	}}
+
+	// pc-a and pc-b both resolve to the shared RuntimeConfig, pc-c resolves
+	// to a different one. Reconciling pc-b alone must still count pc-a's
+	// usages against the shared RuntimeConfig.
+	resolved := map[string]client.Object{
+		"pc-a": rc,
+		"pc-b": rc,
+		"pc-c": otherRC,
+	}
+
+	got := sumUsagesForRuntimeConfig([]resource.ProviderConfig{a, b, c}, resolved, ul, rc)
+	if want := int64(3); got != want {
+		t.Errorf("sumUsagesForRuntimeConfig(...): got %d, want %d", got, want)
+	}
+}
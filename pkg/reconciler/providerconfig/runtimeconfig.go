@@ -0,0 +1,39 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A RuntimeConfigResolver resolves the merged view of a ProviderConfig and
+// the DeploymentRuntimeConfig it references, for use at Connect time.
+type RuntimeConfigResolver func(ctx context.Context, pc resource.ProviderConfig) (client.Object, error)
+
+// WithRuntimeConfigResolver specifies how the Reconciler should resolve the
+// DeploymentRuntimeConfig referenced by a ProviderConfig. The resolved
+// runtime config is merged into the ProviderConfig's usage accounting, so
+// that a runtime config cannot be deleted while it is in use.
+func WithRuntimeConfigResolver(rc RuntimeConfigResolver) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.resolveRuntimeConfig = rc
+	}
+}
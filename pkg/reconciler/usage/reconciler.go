@@ -0,0 +1,159 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usage provides a reconciler that tracks Usage resources, which
+// record that one resource is in use by another and block the used
+// resource's deletion while that is true.
+package usage
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// InUseFinalizer is added to a resource that is referenced by at least one
+// Usage, to block its deletion until every such Usage is removed.
+const InUseFinalizer = "in-use.crossplane.io"
+
+const (
+	errGetUsage     = "cannot get usage"
+	errListUsages   = "cannot list usages"
+	errGetUsed      = "cannot get used resource"
+	errAddFinalizer = "cannot add in-use finalizer to used resource"
+	errRemFinalizer = "cannot remove in-use finalizer from used resource"
+	errUpdateStatus = "cannot update used resource status"
+)
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// A Reconciler reconciles Usages. Because more than one Usage may reference
+// the same used resource, it recounts every live Usage that references the
+// resource used by the Usage under reconciliation before deciding whether to
+// add or remove the resource's in-use finalizer, and records the resulting
+// count in the used resource's status.users.
+type Reconciler struct {
+	client client.Client
+
+	newUsage     func() resource.Usage
+	newUsageList func() resource.UsageList
+
+	finalizer resource.Finalizer
+
+	log logging.Logger
+}
+
+// NewReconciler returns a Reconciler of Usages produced by the supplied
+// function, whose peers are listed by the supplied UsageList function.
+func NewReconciler(c client.Client, of func() resource.Usage, ofList func() resource.UsageList, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client:       c,
+		newUsage:     of,
+		newUsageList: ofList,
+		finalizer:    resource.NewAPIFinalizer(c, InUseFinalizer),
+		log:          logging.NewNopLogger(),
+	}
+
+	for _, ro := range o {
+		ro(r)
+	}
+
+	return r
+}
+
+// Reconcile a Usage by recounting every live Usage that references the same
+// used resource, then ensuring that resource's in-use finalizer and
+// status.users reflect the result.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	u := r.newUsage()
+	if err := r.client.Get(ctx, req.NamespacedName, u); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetUsage)
+	}
+
+	ref := u.GetUsedResourceReference()
+
+	used := &unstructured.Unstructured{}
+	used.SetGroupVersionKind(ref.GroupVersionKind())
+	if err := r.client.Get(ctx, client.ObjectKey{Name: ref.Name}, used); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetUsed)
+	}
+
+	ul := r.newUsageList()
+	if err := r.client.List(ctx, ul); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListUsages)
+	}
+
+	count := countUsages(ul, ref)
+
+	if count > 0 {
+		if err := r.finalizer.AddFinalizer(ctx, used); err != nil {
+			log.Debug(errAddFinalizer, "error", err)
+			return reconcile.Result{}, errors.Wrap(err, errAddFinalizer)
+		}
+	} else if err := r.finalizer.RemoveFinalizer(ctx, used); err != nil {
+		log.Debug(errRemFinalizer, "error", err)
+		return reconcile.Result{}, errors.Wrap(err, errRemFinalizer)
+	}
+
+	if err := unstructured.SetNestedField(used.Object, count, "status", "users"); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, used), errUpdateStatus)
+}
+
+// countUsages returns the number of live (non-deleted) Usages in ul that
+// reference the same used resource as ref. Multiple Usages can reference the
+// same used resource, so this must be summed across all of them, not just
+// the Usage being reconciled.
+func countUsages(ul resource.UsageList, ref xpv1.TypedReference) int64 {
+	var count int64
+	for _, other := range ul.GetItems() {
+		if meta.WasDeleted(other) {
+			continue
+		}
+		oref := other.GetUsedResourceReference()
+		if oref.GroupVersionKind() == ref.GroupVersionKind() && oref.Name == ref.Name {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type usageResource struct {
+	metav1.ObjectMeta
+	fake.GVK
+
+	fake.UsageReferencer
+}
+
+type usageList struct {
+	metav1.ListMeta
+	fake.GVK
+
+	Items []*usageResource
+}
+
+func (l *usageList) GetItems() []resource.Usage {
+	out := make([]resource.Usage, len(l.Items))
+	for i := range l.Items {
+		out[i] = l.Items[i]
+	}
+	return out
+}
+
+func (l *usageList) DeepCopyObject() runtime.Object { return l }
+
+func newUsage(name, usedName, usedKind string) *usageResource {
+	u := &usageResource{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	u.UsageReferencer.SetUsedResourceReference(xpv1.TypedReference{Name: usedName, Kind: usedKind})
+	return u
+}
+
+func TestCountUsages(t *testing.T) {
+	ref := xpv1.TypedReference{Name: "cool-resource", Kind: "Widget"}
+
+	cases := map[string]struct {
+		ul   *usageList
+		ref  xpv1.TypedReference
+		want int64
+	}{
+		"None": {
+			ul:   &usageList{},
+			ref:  ref,
+			want: 0,
+		},
+		"MultipleUsagesOfSameResource": {
+			ul: &usageList{Items: []*usageResource{
+				newUsage("usage-a", "cool-resource", "Widget"),
+				newUsage("usage-b", "cool-resource", "Widget"),
+				newUsage("usage-c", "other-resource", "Widget"),
+			}},
+			ref:  ref,
+			want: 2,
+		},
+		"IgnoresDeleted": {
+			ul: &usageList{Items: []*usageResource{
+				newUsage("usage-a", "cool-resource", "Widget"),
+				func() *usageResource {
+					u := newUsage("usage-b", "cool-resource", "Widget")
+					now := metav1.Now()
+					u.ObjectMeta.DeletionTimestamp = &now
+					return u
+				}(),
+			}},
+			ref:  ref,
+			want: 1,
+		},
+		"IgnoresDifferentKind": {
+			ul: &usageList{Items: []*usageResource{
+				newUsage("usage-a", "cool-resource", "OtherKind"),
+			}},
+			ref:  ref,
+			want: 0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := countUsages(tc.ul, tc.ref); got != tc.want {
+				t.Errorf("countUsages(...): got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake implementations of resource interfaces,
+// intended for use in tests.
+package fake
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GVK returns the given GroupVersionKind on a runtime.Object, so that fake
+// objects can satisfy runtime.Object without a real scheme.
+type GVK struct{ gvk schema.GroupVersionKind }
+
+// SetGroupVersionKind sets the GVK.
+func (o *GVK) SetGroupVersionKind(gvk schema.GroupVersionKind) { o.gvk = gvk }
+
+// GroupVersionKind gets the GVK.
+func (o *GVK) GroupVersionKind() schema.GroupVersionKind { return o.gvk }
+
+// GetObjectKind returns the GVK as a schema.ObjectKind.
+func (o *GVK) GetObjectKind() schema.ObjectKind { return o }
+
+// DeepCopyObject is a no-op that satisfies runtime.Object.
+func (o *GVK) DeepCopyObject() runtime.Object { out := &GVK{o.gvk}; return out }
+
+// A ProviderConfigReferencer fakes resource.ProviderConfigReferencer.
+type ProviderConfigReferencer struct{ Ref *xpv1.Reference }
+
+// SetProviderConfigReference sets the ProviderConfigReference.
+func (r *ProviderConfigReferencer) SetProviderConfigReference(p *xpv1.Reference) { r.Ref = p }
+
+// GetProviderConfigReference gets the ProviderConfigReference.
+func (r *ProviderConfigReferencer) GetProviderConfigReference() *xpv1.Reference { return r.Ref }
+
+// A RuntimeConfigReferencer fakes resource.RuntimeConfigReferencer.
+type RuntimeConfigReferencer struct{ Ref *xpv1.Reference }
+
+// SetRuntimeConfigReference sets the RuntimeConfigReference.
+func (r *RuntimeConfigReferencer) SetRuntimeConfigReference(p *xpv1.Reference) { r.Ref = p }
+
+// GetRuntimeConfigReference gets the RuntimeConfigReference.
+func (r *RuntimeConfigReferencer) GetRuntimeConfigReference() *xpv1.Reference { return r.Ref }
+
+// A ConnectionSecretWriterTo fakes resource.ConnectionSecretWriterTo.
+type ConnectionSecretWriterTo struct{ Ref *xpv1.SecretReference }
+
+// SetWriteConnectionSecretToReference sets the SecretReference.
+func (w *ConnectionSecretWriterTo) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	w.Ref = r
+}
+
+// GetWriteConnectionSecretToReference gets the SecretReference.
+func (w *ConnectionSecretWriterTo) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return w.Ref
+}
+
+// A ConnectionDetailsPublisherTo fakes resource.ConnectionDetailsPublisherTo.
+type ConnectionDetailsPublisherTo struct{ To *xpv1.PublishConnectionDetailsTo }
+
+// SetPublishConnectionDetailsTo sets the PublishConnectionDetailsTo.
+func (p *ConnectionDetailsPublisherTo) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	p.To = r
+}
+
+// GetPublishConnectionDetailsTo gets the PublishConnectionDetailsTo.
+func (p *ConnectionDetailsPublisherTo) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return p.To
+}
+
+// A ConnectionDetailsFetcher fakes resource.ConnectionDetailsFetcher.
+type ConnectionDetailsFetcher struct{ From *xpv1.ConnectionDetailsSource }
+
+// SetFetchConnectionDetailsFrom sets the ConnectionDetailsSource.
+func (f *ConnectionDetailsFetcher) SetFetchConnectionDetailsFrom(r *xpv1.ConnectionDetailsSource) {
+	f.From = r
+}
+
+// GetFetchConnectionDetailsFrom gets the ConnectionDetailsSource.
+func (f *ConnectionDetailsFetcher) GetFetchConnectionDetailsFrom() *xpv1.ConnectionDetailsSource {
+	return f.From
+}
+
+// A Manageable fakes resource.Manageable.
+type Manageable struct{ Policies xpv1.ManagementPolicies }
+
+// SetManagementPolicies sets the ManagementPolicies.
+func (m *Manageable) SetManagementPolicies(p xpv1.ManagementPolicies) { m.Policies = p }
+
+// GetManagementPolicies gets the ManagementPolicies.
+func (m *Manageable) GetManagementPolicies() xpv1.ManagementPolicies { return m.Policies }
+
+// An Orphanable fakes resource.Orphanable.
+type Orphanable struct{ Policy xpv1.DeletionPolicy }
+
+// SetDeletionPolicy sets the DeletionPolicy.
+func (o *Orphanable) SetDeletionPolicy(p xpv1.DeletionPolicy) { o.Policy = p }
+
+// GetDeletionPolicy gets the DeletionPolicy.
+func (o *Orphanable) GetDeletionPolicy() xpv1.DeletionPolicy { return o.Policy }
+
+// A CustomReconciliation fakes resource.CustomReconciliation.
+type CustomReconciliation struct{ Policy *xpv1.ReconciliationPolicy }
+
+// SetReconciliationPolicy sets the ReconciliationPolicy.
+func (c *CustomReconciliation) SetReconciliationPolicy(p *xpv1.ReconciliationPolicy) { c.Policy = p }
+
+// GetReconciliationPolicy gets the ReconciliationPolicy.
+func (c *CustomReconciliation) GetReconciliationPolicy() *xpv1.ReconciliationPolicy { return c.Policy }
+
+// A PollJitterer fakes resource.PollJitterer.
+type PollJitterer struct{ Percent *float64 }
+
+// SetPollJitterPercent sets the jitter percent.
+func (j *PollJitterer) SetPollJitterPercent(p *float64) { j.Percent = p }
+
+// GetPollJitterPercent gets the jitter percent.
+func (j *PollJitterer) GetPollJitterPercent() *float64 { return j.Percent }
+
+// A Conditioned fakes resource.Conditioned.
+type Conditioned struct{ Conditions []xpv1.Condition }
+
+// SetConditions sets the Conditions.
+func (c *Conditioned) SetConditions(ct ...xpv1.Condition) {
+	for _, new := range ct {
+		exists := false
+		for i, existing := range c.Conditions {
+			if existing.Type != new.Type {
+				continue
+			}
+			c.Conditions[i] = new
+			exists = true
+		}
+		if !exists {
+			c.Conditions = append(c.Conditions, new)
+		}
+	}
+}
+
+// GetCondition gets the Condition of the supplied type.
+func (c *Conditioned) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	for _, existing := range c.Conditions {
+		if existing.Type == ct {
+			return existing
+		}
+	}
+	return xpv1.Condition{Type: ct, Status: corev1.ConditionUnknown}
+}
+
+// A Managed is a fake implementation of resource.Managed, for use in tests
+// that only need to exercise logic that reads and writes a Managed's
+// well-known interfaces, not a real Kubernetes object.
+type Managed struct {
+	metav1.ObjectMeta
+	GVK
+
+	ProviderConfigReferencer
+	ConnectionSecretWriterTo
+	ConnectionDetailsPublisherTo
+	Manageable
+	Orphanable
+	CustomReconciliation
+	PollJitterer
+
+	Conditioned
+}
+
+// A UserCounter fakes resource.UserCounter.
+type UserCounter struct{ Users int64 }
+
+// SetUsers sets the count of users.
+func (c *UserCounter) SetUsers(i int64) { c.Users = i }
+
+// GetUsers gets the count of users.
+func (c *UserCounter) GetUsers() int64 { return c.Users }
+
+// A RequiredProviderConfigReferencer fakes resource.RequiredProviderConfigReferencer.
+type RequiredProviderConfigReferencer struct{ Ref xpv1.Reference }
+
+// SetProviderConfigReference sets the ProviderConfigReference.
+func (r *RequiredProviderConfigReferencer) SetProviderConfigReference(p xpv1.Reference) { r.Ref = p }
+
+// GetProviderConfigReference gets the ProviderConfigReference.
+func (r *RequiredProviderConfigReferencer) GetProviderConfigReference() xpv1.Reference { return r.Ref }
+
+// A RequiredTypedResourceReferencer fakes resource.RequiredTypedResourceReferencer.
+type RequiredTypedResourceReferencer struct{ Ref xpv1.TypedReference }
+
+// SetResourceReference sets the TypedReference.
+func (r *RequiredTypedResourceReferencer) SetResourceReference(ref xpv1.TypedReference) { r.Ref = ref }
+
+// GetResourceReference gets the TypedReference.
+func (r *RequiredTypedResourceReferencer) GetResourceReference() xpv1.TypedReference { return r.Ref }
+
+// A UsageReferencer fakes resource.UsageReferencer.
+type UsageReferencer struct {
+	Used  xpv1.TypedReference
+	Using *xpv1.TypedReference
+}
+
+// SetUsedResourceReference sets the reference to the used resource.
+func (u *UsageReferencer) SetUsedResourceReference(r xpv1.TypedReference) { u.Used = r }
+
+// GetUsedResourceReference gets the reference to the used resource.
+func (u *UsageReferencer) GetUsedResourceReference() xpv1.TypedReference { return u.Used }
+
+// SetUsingResourceReference sets the reference to the using resource.
+func (u *UsageReferencer) SetUsingResourceReference(r *xpv1.TypedReference) { u.Using = r }
+
+// GetUsingResourceReference gets the reference to the using resource.
+func (u *UsageReferencer) GetUsingResourceReference() *xpv1.TypedReference { return u.Using }
@@ -68,6 +68,14 @@ type ConnectionDetailsPublisherTo interface {
 	GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo
 }
 
+// A ConnectionDetailsFetcher may fetch connection details published by
+// something other than the resource itself, e.g. a Helm chart, a
+// pre-existing Secret, or an entry in an external secret store.
+type ConnectionDetailsFetcher interface {
+	SetFetchConnectionDetailsFrom(r *xpv1.ConnectionDetailsSource)
+	GetFetchConnectionDetailsFrom() *xpv1.ConnectionDetailsSource
+}
+
 // A Manageable resource may specify a ManagementPolicies.
 type Manageable interface {
 	SetManagementPolicies(p xpv1.ManagementPolicies)
@@ -85,12 +93,27 @@ type CustomReconciliation interface {
 	GetReconciliationPolicy() *xpv1.ReconciliationPolicy
 }
 
+// A PollJitterer may specify a percentage of jitter to apply to its poll
+// interval, in order to avoid many resources being requeued at exactly the
+// same time.
+type PollJitterer interface {
+	SetPollJitterPercent(p *float64)
+	GetPollJitterPercent() *float64
+}
+
 // A ProviderConfigReferencer may reference a provider config resource.
 type ProviderConfigReferencer interface {
 	GetProviderConfigReference() *xpv1.Reference
 	SetProviderConfigReference(p *xpv1.Reference)
 }
 
+// A RuntimeConfigReferencer may reference a deployment runtime config
+// resource.
+type RuntimeConfigReferencer interface {
+	GetRuntimeConfigReference() *xpv1.Reference
+	SetRuntimeConfigReference(r *xpv1.Reference)
+}
+
 // A RequiredProviderConfigReferencer may reference a provider config resource.
 // Unlike ProviderConfigReferencer, the reference is required (i.e. not nil).
 type RequiredProviderConfigReferencer interface {
@@ -174,6 +197,22 @@ type UserCounter interface {
 	GetUsers() int64
 }
 
+// A Usable resource may have its usage tracked by a Usage, which blocks the
+// resource's deletion while it is in use.
+type Usable interface {
+	UserCounter
+}
+
+// A UsageReferencer references the resources that make up one end of a
+// Usage: the resource that is used, and the resource that is using it.
+type UsageReferencer interface {
+	GetUsedResourceReference() xpv1.TypedReference
+	SetUsedResourceReference(r xpv1.TypedReference)
+
+	GetUsingResourceReference() *xpv1.TypedReference
+	SetUsingResourceReference(r *xpv1.TypedReference)
+}
+
 // A ConnectionDetailsPublishedTimer can record the last time its connection
 // details were published.
 type ConnectionDetailsPublishedTimer interface {
@@ -204,6 +243,7 @@ type Managed interface { //nolint:interfacebloat // This interface has to be big
 	Manageable
 	Orphanable
 	CustomReconciliation
+	PollJitterer
 
 	Conditioned
 }
@@ -220,10 +260,19 @@ type ManagedList interface {
 type ProviderConfig interface {
 	Object
 
-	UserCounter
+	RuntimeConfigReferencer
+	Usable
 	Conditioned
 }
 
+// A ProviderConfigList is a list of provider configs.
+type ProviderConfigList interface {
+	client.ObjectList
+
+	// GetItems returns the list of provider configs.
+	GetItems() []ProviderConfig
+}
+
 // A ProviderConfigUsage indicates a usage of a Crossplane provider config.
 type ProviderConfigUsage interface {
 	Object
@@ -240,6 +289,23 @@ type ProviderConfigUsageList interface {
 	GetItems() []ProviderConfigUsage
 }
 
+// A Usage indicates that one resource is using another, e.g. a managed
+// resource using a ProviderConfig, an EnvironmentConfig, or another managed
+// resource. This generalizes ProviderConfigUsage to arbitrary resource pairs.
+type Usage interface {
+	Object
+
+	UsageReferencer
+}
+
+// A UsageList is a list of usages.
+type UsageList interface {
+	client.ObjectList
+
+	// GetItems returns the list of usages.
+	GetItems() []Usage
+}
+
 // A Composite resource composes one or more Composed resources.
 type Composite interface { //nolint:interfacebloat // This interface has to be big.
 	Object
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// An ImportIdentifier may declare an externally-assigned identifier (e.g. an
+// ARN, or a project/region/name triple) that should be used to look up the
+// external resource it represents, independent of the usual external-name
+// annotation.
+type ImportIdentifier interface {
+	GetImportIdentifier() string
+}
+
+// IsObserveOnly returns true if the supplied Managed resource's management
+// policies reduce to the single Observe policy, meaning its external resource
+// must never be created, updated, or deleted by Crossplane.
+func IsObserveOnly(mg Managed) bool {
+	p := mg.GetManagementPolicies()
+	return len(p) == 1 && p[0] == xpv1.ManagementActionObserve
+}
+
+// GetExternalID returns the identifier that should be used to look up the
+// external resource mg represents. If mg implements ImportIdentifier and
+// declares a non-empty identifier, that takes priority - this lets an
+// observe-only resource be imported by e.g. ARN or project/region/name
+// without ever being assigned the usual external-name annotation. Otherwise
+// the external-name annotation is used, as for any other managed resource.
+func GetExternalID(mg Managed) string {
+	if ii, ok := mg.(ImportIdentifier); ok {
+		if id := ii.GetImportIdentifier(); id != "" {
+			return id
+		}
+	}
+	return meta.GetExternalName(mg)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestIsObserveOnly(t *testing.T) {
+	cases := map[string]struct {
+		mg   *fake.Managed
+		want bool
+	}{
+		"NoPolicies": {
+			mg:   &fake.Managed{},
+			want: false,
+		},
+		"ObserveOnly": {
+			mg: func() *fake.Managed {
+				mg := &fake.Managed{}
+				mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve})
+				return mg
+			}(),
+			want: true,
+		},
+		"ObserveAndCreate": {
+			mg: func() *fake.Managed {
+				mg := &fake.Managed{}
+				mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate})
+				return mg
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsObserveOnly(tc.mg); got != tc.want {
+				t.Errorf("IsObserveOnly(...): got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+type importIdentifiable struct {
+	*fake.Managed
+	id string
+}
+
+func (i *importIdentifiable) GetImportIdentifier() string { return i.id }
+
+func TestGetExternalID(t *testing.T) {
+	withAnnotation := &fake.Managed{}
+	meta.SetExternalName(withAnnotation, "name-from-annotation")
+
+	cases := map[string]struct {
+		mg   Managed
+		want string
+	}{
+		"FallsBackToAnnotation": {
+			mg:   withAnnotation,
+			want: "name-from-annotation",
+		},
+		"PrefersImportIdentifier": {
+			mg:   &importIdentifiable{Managed: withAnnotation, id: "arn:aws:imported"},
+			want: "arn:aws:imported",
+		},
+		"IgnoresEmptyImportIdentifier": {
+			mg:   &importIdentifiable{Managed: withAnnotation, id: ""},
+			want: "name-from-annotation",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := GetExternalID(tc.mg); got != tc.want {
+				t.Errorf("GetExternalID(...): got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errNoRuntimeConfigRef = "provider config does not reference a runtime config"
+
+// GetRuntimeConfig fetches the DeploymentRuntimeConfig referenced by the
+// supplied RuntimeConfigReferencer into obj. It returns an error if the
+// referencer has no runtime config reference.
+func GetRuntimeConfig(ctx context.Context, c client.Client, pc RuntimeConfigReferencer, obj client.Object) error {
+	ref := pc.GetRuntimeConfigReference()
+	if ref == nil {
+		return errors.New(errNoRuntimeConfigRef)
+	}
+
+	return c.Get(ctx, client.ObjectKey{Name: ref.Name}, obj)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"bytes"
+	"text/template"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// maxTemplateSize bounds the size of a TemplateConnectionDetailTransform's
+// template, so that a malformed or malicious template can't consume
+// excessive memory or CPU at parse or execute time.
+const maxTemplateSize = 4096
+
+const (
+	errUnknownTransformType = "unknown connection detail transform type"
+	errTemplateTooLarge     = "template exceeds the maximum supported size"
+	errParseTemplate        = "cannot parse template"
+	errExecuteTemplate      = "cannot execute template"
+	errRequiredDetailFmt    = "required connection detail %q is missing"
+)
+
+// ApplyConnectionDetailTransforms applies the supplied, ordered transforms to
+// a set of connection details, returning the result. Transforms are applied
+// in order, so e.g. a rename followed by a drop acts on the renamed key.
+func ApplyConnectionDetailTransforms(details map[string][]byte, transforms ...xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	out := make(map[string][]byte, len(details))
+	for k, v := range details {
+		out[k] = v
+	}
+
+	for _, t := range transforms {
+		var err error
+		switch t.Type {
+		case xpv1.ConnectionDetailTransformTypeRename:
+			out, err = renameConnectionDetail(out, t)
+		case xpv1.ConnectionDetailTransformTypeTemplate:
+			out, err = templateConnectionDetail(out, t)
+		case xpv1.ConnectionDetailTransformTypeMap:
+			out, err = mapConnectionDetail(out, t)
+		case xpv1.ConnectionDetailTransformTypeDrop:
+			out, err = dropConnectionDetail(out, t)
+		case xpv1.ConnectionDetailTransformTypeRequire:
+			out, err = requireConnectionDetail(out, t)
+		default:
+			return nil, errors.Errorf("%s: %q", errUnknownTransformType, t.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+func renameConnectionDetail(details map[string][]byte, t xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	v, ok := details[t.Rename.Key]
+	if !ok {
+		return details, nil
+	}
+	delete(details, t.Rename.Key)
+	details[t.Rename.To] = v
+	return details, nil
+}
+
+func templateConnectionDetail(details map[string][]byte, t xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	if len(t.Template.Template) > maxTemplateSize {
+		return nil, errors.New(errTemplateTooLarge)
+	}
+
+	tmpl, err := template.New(t.Template.Key).Parse(t.Template.Template)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseTemplate)
+	}
+
+	data := make(map[string]string, len(details))
+	for k, v := range details {
+		data[k] = string(v)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, errExecuteTemplate)
+	}
+
+	details[t.Template.Key] = buf.Bytes()
+	return details, nil
+}
+
+func mapConnectionDetail(details map[string][]byte, t xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	v, ok := details[t.Map.Key]
+	if !ok {
+		return details, nil
+	}
+	mapped, ok := t.Map.Pairs[string(v)]
+	if !ok {
+		return details, nil
+	}
+	details[t.Map.Key] = []byte(mapped)
+	return details, nil
+}
+
+func dropConnectionDetail(details map[string][]byte, t xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	delete(details, t.Drop.Key)
+	return details, nil
+}
+
+func requireConnectionDetail(details map[string][]byte, t xpv1.ConnectionDetailTransform) (map[string][]byte, error) {
+	if _, ok := details[t.Require.Key]; !ok {
+		return nil, errors.Errorf(errRequiredDetailFmt, t.Require.Key)
+	}
+	return details, nil
+}
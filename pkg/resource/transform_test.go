@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+func TestApplyConnectionDetailTransforms(t *testing.T) {
+	cases := map[string]struct {
+		details    map[string][]byte
+		transforms []xpv1.ConnectionDetailTransform
+		want       map[string][]byte
+		wantErr    string
+	}{
+		"Rename": {
+			details: map[string][]byte{"username": []byte("cool")},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeRename, Rename: &xpv1.RenameConnectionDetailTransform{Key: "username", To: "user"}},
+			},
+			want: map[string][]byte{"user": []byte("cool")},
+		},
+		"Template": {
+			details: map[string][]byte{"host": []byte("example.org"), "port": []byte("5432")},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeTemplate, Template: &xpv1.TemplateConnectionDetailTransform{Key: "endpoint", Template: "{{ .host }}:{{ .port }}"}},
+			},
+			want: map[string][]byte{"host": []byte("example.org"), "port": []byte("5432"), "endpoint": []byte("example.org:5432")},
+		},
+		"TemplateTooLarge": {
+			details: map[string][]byte{},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeTemplate, Template: &xpv1.TemplateConnectionDetailTransform{Key: "endpoint", Template: strings.Repeat("a", maxTemplateSize+1)}},
+			},
+			wantErr: errTemplateTooLarge,
+		},
+		"Map": {
+			details: map[string][]byte{"tier": []byte("gold")},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeMap, Map: &xpv1.MapConnectionDetailTransform{Key: "tier", Pairs: map[string]string{"gold": "premium"}}},
+			},
+			want: map[string][]byte{"tier": []byte("premium")},
+		},
+		"Drop": {
+			details: map[string][]byte{"username": []byte("cool"), "debug": []byte("true")},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeDrop, Drop: &xpv1.DropConnectionDetailTransform{Key: "debug"}},
+			},
+			want: map[string][]byte{"username": []byte("cool")},
+		},
+		"RequirePresent": {
+			details: map[string][]byte{"username": []byte("cool")},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeRequire, Require: &xpv1.RequireConnectionDetailTransform{Key: "username"}},
+			},
+			want: map[string][]byte{"username": []byte("cool")},
+		},
+		"RequireMissing": {
+			details: map[string][]byte{},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformTypeRequire, Require: &xpv1.RequireConnectionDetailTransform{Key: "username"}},
+			},
+			wantErr: "required connection detail",
+		},
+		"UnknownType": {
+			details: map[string][]byte{},
+			transforms: []xpv1.ConnectionDetailTransform{
+				{Type: xpv1.ConnectionDetailTransformType("Bogus")},
+			},
+			wantErr: errUnknownTransformType,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ApplyConnectionDetailTransforms(tc.details, tc.transforms...)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("ApplyConnectionDetailTransforms(...): got err %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyConnectionDetailTransforms(...): unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ApplyConnectionDetailTransforms(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}